@@ -0,0 +1,160 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseChecksums(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "valid lines",
+			data: "abc123  task_linux_amd64.tar.gz\ndef456  task_darwin_amd64.tar.gz\n",
+			want: map[string]string{
+				"task_linux_amd64.tar.gz":  "abc123",
+				"task_darwin_amd64.tar.gz": "def456",
+			},
+		},
+		{
+			name: "uppercase digest is lowercased",
+			data: "ABC123  task_linux_amd64.tar.gz\n",
+			want: map[string]string{"task_linux_amd64.tar.gz": "abc123"},
+		},
+		{
+			name: "blank lines are skipped",
+			data: "abc123  task_linux_amd64.tar.gz\n\n\ndef456  task_darwin_amd64.tar.gz\n",
+			want: map[string]string{
+				"task_linux_amd64.tar.gz":  "abc123",
+				"task_darwin_amd64.tar.gz": "def456",
+			},
+		},
+		{
+			name: "CRLF line endings",
+			data: "abc123  task_linux_amd64.tar.gz\r\ndef456  task_darwin_amd64.tar.gz\r\n",
+			want: map[string]string{
+				"task_linux_amd64.tar.gz":  "abc123",
+				"task_darwin_amd64.tar.gz": "def456",
+			},
+		},
+		{
+			name: "duplicate filename keeps the last entry",
+			data: "abc123  task_linux_amd64.tar.gz\ndef456  task_linux_amd64.tar.gz\n",
+			want: map[string]string{"task_linux_amd64.tar.gz": "def456"},
+		},
+		{
+			name:    "malformed line with only a digest",
+			data:    "abc123\n",
+			wantErr: true,
+		},
+		{
+			name:    "malformed line with extra fields",
+			data:    "abc123  task_linux_amd64.tar.gz  extra\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseChecksums([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseChecksums() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("parseChecksums() = %v, want %v", got, tt.want)
+			}
+			for name, digest := range tt.want {
+				if got[name] != digest {
+					t.Errorf("parseChecksums()[%q] = %q, want %q", name, got[name], digest)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckDownloadChecksumSkip(t *testing.T) {
+	err := checkDownloadChecksum("task", "archive.tar.gz", "task_linux_amd64.tar.gz", "somedigest", "v1.0.0", true, "")
+	if err != nil {
+		t.Fatalf("expected skip-checksum to short-circuit without error, got: %v", err)
+	}
+}
+
+func TestCheckDownloadChecksumOverrideMatch(t *testing.T) {
+	digest := "ABCDEF0123456789"
+	if err := checkDownloadChecksum("task", "archive.tar.gz", "task_linux_amd64.tar.gz", digest, "v1.0.0", false, digest); err != nil {
+		t.Fatalf("expected matching override to pass, got: %v", err)
+	}
+
+	// The comparison should be case-insensitive.
+	if err := checkDownloadChecksum("gh", "archive.zip", "gh_linux_amd64.tar.gz", "abcdef0123456789", "v1.0.0", false, "ABCDEF0123456789"); err != nil {
+		t.Fatalf("expected case-insensitive override match to pass, got: %v", err)
+	}
+}
+
+func TestCheckDownloadChecksumOverrideMismatch(t *testing.T) {
+	err := checkDownloadChecksum("task", "archive.tar.gz", "task_linux_amd64.tar.gz", "digest-a", "v1.0.0", false, "digest-b")
+	if err == nil {
+		t.Fatal("expected mismatched override to fail")
+	}
+}
+
+func TestCheckDownloadChecksumNoEntryForNonTask(t *testing.T) {
+	// With neither --skip-checksum nor --checksum, a tool other than
+	// "task" has no published checksum file this code knows how to
+	// fetch, so it should skip verification rather than fail.
+	err := checkDownloadChecksum("gh", "archive.zip", "gh_linux_amd64.tar.gz", "somedigest", "v1.0.0", false, "")
+	if err != nil {
+		t.Fatalf("expected non-task tool without --checksum to skip verification, got: %v", err)
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.bin")
+	if err := os.WriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+
+	got, err := hashFile(path)
+	if err != nil {
+		t.Fatalf("hashFile failed: %v", err)
+	}
+
+	want := "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	if got != want {
+		t.Fatalf("hashFile(%q) = %q, want %q", "hello", got, want)
+	}
+}
+
+func TestVerifyArchive(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "file.bin")
+	if err := os.WriteFile(path, []byte("hello"), 0644); err != nil {
+		t.Fatalf("failed to write test file: %v", err)
+	}
+	digest, err := hashFile(path)
+	if err != nil {
+		t.Fatalf("hashFile failed: %v", err)
+	}
+
+	checksums := map[string]string{"file.bin": digest}
+
+	if err := verifyArchive(path, "file.bin", checksums); err != nil {
+		t.Fatalf("expected matching digest to pass, got: %v", err)
+	}
+
+	if err := verifyArchive(path, "other.bin", checksums); err == nil {
+		t.Fatal("expected missing checksum entry to fail")
+	}
+
+	checksums["file.bin"] = "0000000000000000000000000000000000000000000000000000000000000000"
+	if err := verifyArchive(path, "file.bin", checksums); err == nil {
+		t.Fatal("expected digest mismatch to fail")
+	}
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// safeJoin joins name onto dest after cleaning it as if it were rooted at
+// dest, so that "../" segments and absolute paths cannot climb out of dest
+// (a "Zip-Slip" entry such as "../../etc/passwd" becomes dest/etc/passwd
+// rather than an error). Any result that still resolves outside dest is
+// rejected.
+func safeJoin(dest, name string) (string, error) {
+	cleaned := filepath.Clean(string(filepath.Separator) + name)
+	target := filepath.Join(dest, cleaned)
+
+	rel, err := filepath.Rel(dest, target)
+	if err != nil {
+		return "", fmt.Errorf("invalid archive entry %q: %w", name, err)
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+		return "", fmt.Errorf("archive entry %q escapes destination directory", name)
+	}
+
+	return target, nil
+}
+
+// checkLinkTarget reports an error if the symlink/hardlink at linkPath
+// (already validated to be inside dest) would resolve to somewhere outside
+// dest.
+func checkLinkTarget(dest, linkPath, linkname string, relativeToLink bool) error {
+	var resolved string
+	if relativeToLink {
+		if filepath.IsAbs(linkname) {
+			resolved = filepath.Clean(linkname)
+		} else {
+			resolved = filepath.Join(filepath.Dir(linkPath), linkname)
+		}
+	} else {
+		resolved = filepath.Join(dest, filepath.Clean(string(filepath.Separator)+linkname))
+	}
+
+	rel, err := filepath.Rel(dest, resolved)
+	if err != nil {
+		return fmt.Errorf("invalid link target %q: %w", linkname, err)
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+		return fmt.Errorf("link target %q escapes destination directory", linkname)
+	}
+
+	return nil
+}
+
+// copyCapped copies src into dst, decrementing remaining by the number of
+// bytes written and returning an error once remaining would go negative,
+// guarding against zip/gzip decompression bombs.
+func copyCapped(dst io.Writer, src io.Reader, remaining *int64) error {
+	if *remaining < 0 {
+		return fmt.Errorf("archive exceeds max-extract-bytes limit")
+	}
+
+	n, err := io.Copy(dst, io.LimitReader(src, *remaining+1))
+	if err != nil {
+		return err
+	}
+	if n > *remaining {
+		return fmt.Errorf("archive exceeds max-extract-bytes limit")
+	}
+	*remaining -= n
+
+	return nil
+}
+
+// extractZip unpacks the zip archive at src into dest, rejecting entries
+// that would escape dest, honoring Unix permission bits and symlinks
+// recorded in the external attributes when present, restoring mtimes, and
+// capping total decompressed bytes at maxExtractBytes.
+func extractZip(src, dest string, maxExtractBytes int64) error {
+	r, err := zip.OpenReader(src)
+	if err != nil {
+		return fmt.Errorf("failed to open zip: %w", err)
+	}
+	defer r.Close()
+
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return fmt.Errorf("failed to create destination directory: %w", err)
+	}
+
+	remaining := maxExtractBytes
+
+	for _, f := range r.File {
+		target, err := safeJoin(dest, f.Name)
+		if err != nil {
+			return err
+		}
+
+		mode := f.Mode()
+
+		if f.FileInfo().IsDir() {
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("failed to open %s in zip: %w", f.Name, err)
+		}
+
+		if mode&os.ModeSymlink != 0 {
+			linkname, err := io.ReadAll(io.LimitReader(rc, 4096))
+			rc.Close()
+			if err != nil {
+				return fmt.Errorf("failed to read symlink target for %s: %w", f.Name, err)
+			}
+			if err := checkLinkTarget(dest, target, string(linkname), true); err != nil {
+				return err
+			}
+			os.Remove(target)
+			if err := os.Symlink(string(linkname), target); err != nil {
+				return fmt.Errorf("failed to create symlink %s: %w", target, err)
+			}
+			continue
+		}
+
+		out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode.Perm()|0600)
+		if err != nil {
+			rc.Close()
+			return fmt.Errorf("failed to create %s: %w", target, err)
+		}
+
+		copyErr := copyCapped(out, rc, &remaining)
+		rc.Close()
+		out.Close()
+		if copyErr != nil {
+			return fmt.Errorf("failed to extract %s: %w", f.Name, copyErr)
+		}
+
+		if mode.Perm() != 0 {
+			if err := os.Chmod(target, mode.Perm()); err != nil {
+				return fmt.Errorf("failed to set permissions on %s: %w", target, err)
+			}
+		}
+		if modTime := f.Modified; !modTime.IsZero() {
+			_ = os.Chtimes(target, modTime, modTime)
+		}
+	}
+
+	return nil
+}
+
+// extractTarGz unpacks the gzip-compressed tar archive at src into dest,
+// rejecting entries that would escape dest, recreating symlinks and hard
+// links only when their targets resolve inside dest, restoring permissions
+// and mtimes from the tar headers, and capping total decompressed bytes at
+// maxExtractBytes.
+func extractTarGz(src, dest string, maxExtractBytes int64) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("failed to open gzip stream: %w", err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(dest, 0755); err != nil {
+		return fmt.Errorf("failed to create destination directory: %w", err)
+	}
+
+	remaining := maxExtractBytes
+	tr := tar.NewReader(gz)
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read tar entry: %w", err)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeXGlobalHeader, tar.TypeXHeader:
+			continue
+		}
+
+		target, err := safeJoin(dest, hdr.Name)
+		if err != nil {
+			return err
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.FileMode(hdr.Mode)&os.ModePerm|0700); err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+			}
+
+			out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, os.FileMode(hdr.Mode)&os.ModePerm|0600)
+			if err != nil {
+				return fmt.Errorf("failed to create %s: %w", target, err)
+			}
+
+			copyErr := copyCapped(out, tr, &remaining)
+			out.Close()
+			if copyErr != nil {
+				return fmt.Errorf("failed to extract %s: %w", hdr.Name, copyErr)
+			}
+
+			if err := os.Chmod(target, os.FileMode(hdr.Mode)&os.ModePerm); err != nil {
+				return fmt.Errorf("failed to set permissions on %s: %w", target, err)
+			}
+			if !hdr.ModTime.IsZero() {
+				_ = os.Chtimes(target, hdr.ModTime, hdr.ModTime)
+			}
+
+		case tar.TypeSymlink:
+			if err := checkLinkTarget(dest, target, hdr.Linkname, true); err != nil {
+				return err
+			}
+			os.Remove(target)
+			if err := os.Symlink(hdr.Linkname, target); err != nil {
+				return fmt.Errorf("failed to create symlink %s: %w", target, err)
+			}
+
+		case tar.TypeLink:
+			if err := checkLinkTarget(dest, target, hdr.Linkname, false); err != nil {
+				return err
+			}
+			oldname, err := safeJoin(dest, hdr.Linkname)
+			if err != nil {
+				return err
+			}
+			os.Remove(target)
+			if err := os.Link(oldname, target); err != nil {
+				return fmt.Errorf("failed to create hard link %s: %w", target, err)
+			}
+
+		default:
+			// Skip device files, fifos, and anything else we don't need
+			// to materialize for an installed binary.
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeZip(t *testing.T, entries map[string]string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range entries {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("failed to create zip entry %s: %v", name, err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("failed to write zip entry %s: %v", name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("failed to close zip writer: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "archive.zip")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("failed to write zip file: %v", err)
+	}
+	return path
+}
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	linkname string
+	content  string
+}
+
+func writeTarGz(t *testing.T, entries []tarEntry) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Name:     e.name,
+			Typeflag: e.typeflag,
+			Linkname: e.linkname,
+			Mode:     0644,
+			Size:     int64(len(e.content)),
+		}
+		if e.typeflag == 0 {
+			hdr.Typeflag = tar.TypeReg
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			t.Fatalf("failed to write tar header for %s: %v", e.name, err)
+		}
+		if e.content != "" {
+			if _, err := tw.Write([]byte(e.content)); err != nil {
+				t.Fatalf("failed to write tar content for %s: %v", e.name, err)
+			}
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("failed to close tar writer: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("failed to close gzip writer: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "archive.tar.gz")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatalf("failed to write tar.gz file: %v", err)
+	}
+	return path
+}
+
+func TestSafeJoin(t *testing.T) {
+	dest := "/tmp/extract-dest"
+
+	tests := []struct {
+		name    string
+		entry   string
+		wantErr bool
+	}{
+		{"plain file", "bin/task", false},
+		{"nested traversal", "../../../etc/passwd", false}, // cleaned to stay inside dest
+		{"absolute path", "/etc/passwd", false},            // cleaned to stay inside dest
+		{"dot segments", "./a/./b", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target, err := safeJoin(dest, tt.entry)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("safeJoin(%q) error = %v, wantErr %v", tt.entry, err, tt.wantErr)
+			}
+			if err == nil {
+				rel, relErr := filepath.Rel(dest, target)
+				if relErr != nil || rel == ".." || filepath.IsAbs(rel) {
+					t.Fatalf("safeJoin(%q) = %q escapes dest %q", tt.entry, target, dest)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractZipContainsZipSlip(t *testing.T) {
+	archive := writeZip(t, map[string]string{
+		"../../../../tmp/evil.txt": "pwned",
+	})
+	dest := t.TempDir()
+
+	if err := extractZip(archive, dest, defaultMaxExtractBytes); err != nil {
+		t.Fatalf("extractZip returned error for traversal entry: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dest, "tmp", "evil.txt")); err != nil {
+		t.Fatalf("expected traversal entry to be contained under dest, got: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(dest), "evil.txt")); err == nil {
+		t.Fatalf("zip-slip entry escaped the destination directory")
+	}
+}
+
+func TestExtractZipValidArchive(t *testing.T) {
+	archive := writeZip(t, map[string]string{
+		"bin/task": "#!/bin/sh\necho hi\n",
+	})
+	dest := t.TempDir()
+
+	if err := extractZip(archive, dest, defaultMaxExtractBytes); err != nil {
+		t.Fatalf("extractZip failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dest, "bin", "task"))
+	if err != nil {
+		t.Fatalf("expected extracted file, got: %v", err)
+	}
+	if string(data) != "#!/bin/sh\necho hi\n" {
+		t.Fatalf("unexpected extracted content: %q", data)
+	}
+}
+
+func TestExtractTarGzContainsTarSlip(t *testing.T) {
+	archive := writeTarGz(t, []tarEntry{
+		{name: "../../../../tmp/evil.txt", content: "pwned"},
+	})
+	dest := t.TempDir()
+
+	if err := extractTarGz(archive, dest, defaultMaxExtractBytes); err != nil {
+		t.Fatalf("extractTarGz returned error for traversal entry: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(filepath.Dir(dest), "evil.txt")); err == nil {
+		t.Fatalf("tar-slip entry escaped the destination directory")
+	}
+}
+
+func TestExtractTarGzRejectsEscapingSymlink(t *testing.T) {
+	archive := writeTarGz(t, []tarEntry{
+		{name: "evil-link", typeflag: tar.TypeSymlink, linkname: "../../../../etc"},
+	})
+	dest := t.TempDir()
+
+	if err := extractTarGz(archive, dest, defaultMaxExtractBytes); err == nil {
+		t.Fatalf("expected error for symlink escaping destination directory")
+	}
+}
+
+func TestExtractTarGzRejectsEscapingHardLink(t *testing.T) {
+	archive := writeTarGz(t, []tarEntry{
+		{name: "evil-link", typeflag: tar.TypeLink, linkname: "../../../../etc/passwd"},
+	})
+	dest := t.TempDir()
+
+	if err := extractTarGz(archive, dest, defaultMaxExtractBytes); err == nil {
+		t.Fatalf("expected error for hard link escaping destination directory")
+	}
+}
+
+func TestExtractTarGzValidArchive(t *testing.T) {
+	archive := writeTarGz(t, []tarEntry{
+		{name: "bin/task", content: "#!/bin/sh\necho hi\n"},
+	})
+	dest := t.TempDir()
+
+	if err := extractTarGz(archive, dest, defaultMaxExtractBytes); err != nil {
+		t.Fatalf("extractTarGz failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dest, "bin", "task"))
+	if err != nil {
+		t.Fatalf("expected extracted file, got: %v", err)
+	}
+	if string(data) != "#!/bin/sh\necho hi\n" {
+		t.Fatalf("unexpected extracted content: %q", data)
+	}
+}
+
+func TestExtractTarGzEnforcesMaxExtractBytes(t *testing.T) {
+	archive := writeTarGz(t, []tarEntry{
+		{name: "big.bin", content: "0123456789"},
+	})
+	dest := t.TempDir()
+
+	if err := extractTarGz(archive, dest, 4); err == nil {
+		t.Fatalf("expected error when archive exceeds max-extract-bytes")
+	}
+}
+
+func TestExtractZipEnforcesMaxExtractBytes(t *testing.T) {
+	archive := writeZip(t, map[string]string{
+		"big.bin": "0123456789",
+	})
+	dest := t.TempDir()
+
+	if err := extractZip(archive, dest, 4); err == nil {
+		t.Fatalf("expected error when archive exceeds max-extract-bytes")
+	}
+}
@@ -0,0 +1,205 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Selector is a boolean combination of version constraints, parsed from
+// an expression such as "1.x", "~1.2", "^1.2.3", ">=1.2.0 <2.0.0", or
+// "*". A Version matches a Selector when it satisfies every constraint.
+type Selector struct {
+	constraints []constraint
+	expr        string
+}
+
+type op int
+
+const (
+	opGTE op = iota
+	opGT
+	opLTE
+	opLT
+	opEQ
+)
+
+type constraint struct {
+	op op
+	v  Version
+}
+
+func (c constraint) matches(v Version) bool {
+	cmp := v.Cmp(c.v)
+	switch c.op {
+	case opGTE:
+		return cmp >= 0
+	case opGT:
+		return cmp > 0
+	case opLTE:
+		return cmp <= 0
+	case opLT:
+		return cmp < 0
+	case opEQ:
+		return cmp == 0
+	default:
+		return false
+	}
+}
+
+// String returns the original selector expression.
+func (s Selector) String() string {
+	return s.expr
+}
+
+// Matches reports whether v satisfies every constraint in s.
+func (s Selector) Matches(v Version) bool {
+	for _, c := range s.constraints {
+		if !c.matches(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseSelector parses a version selector expression. Supported forms:
+//
+//   - "*" matches any version
+//   - "1.x", "1.2.x" is an X-range: matches any version within the fixed prefix
+//   - "~1.2" is a tilde range: >=1.2.0 <1.3.0 (patch-level changes)
+//   - "^1.2.3" is a caret range: >=1.2.3 <2.0.0 (compatible changes)
+//   - ">=1.2.0 <2.0.0" is a space-separated list of comparator constraints, ANDed
+func ParseSelector(expr string) (Selector, error) {
+	trimmed := strings.TrimSpace(expr)
+	if trimmed == "" || trimmed == "*" {
+		return Selector{expr: expr}, nil
+	}
+
+	if strings.HasSuffix(trimmed, ".x") || strings.HasSuffix(trimmed, ".X") {
+		return parseXRange(expr, trimmed)
+	}
+
+	if strings.HasPrefix(trimmed, "~") {
+		return parseTilde(expr, trimmed)
+	}
+
+	if strings.HasPrefix(trimmed, "^") {
+		return parseCaret(expr, trimmed)
+	}
+
+	return parseComparatorList(expr, trimmed)
+}
+
+func parseXRange(expr, trimmed string) (Selector, error) {
+	prefix := strings.TrimSuffix(strings.TrimSuffix(trimmed, "x"), "X")
+	prefix = strings.TrimSuffix(prefix, ".")
+	parts := strings.Split(prefix, ".")
+
+	switch len(parts) {
+	case 1: // "1.x" -> >=1.0.0 <2.0.0
+		lo, err := Parse(parts[0] + ".0.0")
+		if err != nil {
+			return Selector{}, fmt.Errorf("semver: invalid selector %q: %w", expr, err)
+		}
+		hi := lo
+		hi.Major++
+		return Selector{expr: expr, constraints: []constraint{{opGTE, lo}, {opLT, hi}}}, nil
+	case 2: // "1.2.x" -> >=1.2.0 <1.3.0
+		lo, err := Parse(parts[0] + "." + parts[1] + ".0")
+		if err != nil {
+			return Selector{}, fmt.Errorf("semver: invalid selector %q: %w", expr, err)
+		}
+		hi := lo
+		hi.Minor++
+		hi.Patch = 0
+		return Selector{expr: expr, constraints: []constraint{{opGTE, lo}, {opLT, hi}}}, nil
+	default:
+		return Selector{}, fmt.Errorf("semver: invalid X-range selector %q", expr)
+	}
+}
+
+func parseTilde(expr, trimmed string) (Selector, error) {
+	versionStr := strings.TrimPrefix(trimmed, "~")
+	lo, err := Parse(versionStr)
+	if err != nil {
+		return Selector{}, fmt.Errorf("semver: invalid selector %q: %w", expr, err)
+	}
+	hi := lo
+	hi.Prerelease = nil
+	if coreComponents(versionStr) < 2 {
+		// "~1" has no minor component: allow any minor/patch change
+		// within the major version, same as "^1".
+		hi.Major++
+		hi.Minor, hi.Patch = 0, 0
+	} else {
+		hi.Minor++
+		hi.Patch = 0
+	}
+	return Selector{expr: expr, constraints: []constraint{{opGTE, lo}, {opLT, hi}}}, nil
+}
+
+// coreComponents returns how many dot-separated major.minor.patch
+// components are present in the core version portion of s (before any
+// prerelease or build metadata), ignoring a leading "v".
+func coreComponents(s string) int {
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		s = s[:i]
+	}
+	return len(strings.Split(s, "."))
+}
+
+func parseCaret(expr, trimmed string) (Selector, error) {
+	lo, err := Parse(strings.TrimPrefix(trimmed, "^"))
+	if err != nil {
+		return Selector{}, fmt.Errorf("semver: invalid selector %q: %w", expr, err)
+	}
+	hi := lo
+	hi.Prerelease = nil
+	switch {
+	case lo.Major > 0:
+		hi.Major++
+		hi.Minor, hi.Patch = 0, 0
+	case lo.Minor > 0:
+		hi.Minor++
+		hi.Patch = 0
+	default:
+		hi.Patch++
+	}
+	return Selector{expr: expr, constraints: []constraint{{opGTE, lo}, {opLT, hi}}}, nil
+}
+
+func parseComparatorList(expr, trimmed string) (Selector, error) {
+	var constraints []constraint
+	for _, token := range strings.Fields(trimmed) {
+		o, rest := splitComparator(token)
+		v, err := Parse(rest)
+		if err != nil {
+			return Selector{}, fmt.Errorf("semver: invalid selector %q: %w", expr, err)
+		}
+		constraints = append(constraints, constraint{o, v})
+	}
+	if len(constraints) == 0 {
+		return Selector{}, fmt.Errorf("semver: empty selector %q", expr)
+	}
+	return Selector{expr: expr, constraints: constraints}, nil
+}
+
+func splitComparator(token string) (op, string) {
+	switch {
+	case strings.HasPrefix(token, ">="):
+		return opGTE, token[2:]
+	case strings.HasPrefix(token, "<="):
+		return opLTE, token[2:]
+	case strings.HasPrefix(token, ">"):
+		return opGT, token[1:]
+	case strings.HasPrefix(token, "<"):
+		return opLT, token[1:]
+	case strings.HasPrefix(token, "="):
+		return opEQ, token[1:]
+	default:
+		return opEQ, token
+	}
+}
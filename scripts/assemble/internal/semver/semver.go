@@ -0,0 +1,160 @@
+// Package semver implements parsing and ordering of version strings
+// following the SemVer 2.0.0 specification (https://semver.org), along
+// with a small selector language for filtering a set of versions.
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is a parsed SemVer 2.0.0 version.
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	Prerelease []string
+	Build      string
+
+	raw string
+}
+
+// String returns the string v was parsed from, exactly as given to Parse:
+// it keeps any "v" prefix and does not fill in omitted minor or patch
+// components. Fields changed after parsing are not reflected.
+func (v Version) String() string {
+	return v.raw
+}
+
+// Core returns "major.minor.patch" without prerelease or build metadata.
+func (v Version) Core() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// IsPrerelease reports whether v has a prerelease component.
+func (v Version) IsPrerelease() bool {
+	return len(v.Prerelease) > 0
+}
+
+// Parse parses s as a SemVer 2.0.0 version. An optional leading "v" is
+// accepted and stripped. Missing minor/patch components default to 0 so
+// that loose tags such as "1.2" or "1" also parse.
+func Parse(s string) (Version, error) {
+	raw := s
+	s = strings.TrimPrefix(s, "v")
+
+	var build string
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		build = s[i+1:]
+		s = s[:i]
+		if build == "" {
+			return Version{}, fmt.Errorf("semver: empty build metadata in %q", raw)
+		}
+	}
+
+	var prerelease []string
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		pre := s[i+1:]
+		s = s[:i]
+		if pre == "" {
+			return Version{}, fmt.Errorf("semver: empty prerelease in %q", raw)
+		}
+		prerelease = strings.Split(pre, ".")
+		for _, ident := range prerelease {
+			if ident == "" {
+				return Version{}, fmt.Errorf("semver: empty prerelease identifier in %q", raw)
+			}
+		}
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return Version{}, fmt.Errorf("semver: too many components in %q", raw)
+	}
+
+	nums := [3]uint64{}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Version{}, fmt.Errorf("semver: invalid numeric component %q in %q", part, raw)
+		}
+		nums[i] = n
+	}
+
+	return Version{
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
+		Prerelease: prerelease,
+		Build:      build,
+		raw:        raw,
+	}, nil
+}
+
+// Cmp compares v and o following SemVer 2.0.0 precedence: major, minor,
+// and patch are compared numerically; a version with a prerelease has
+// lower precedence than the associated normal version; two prereleases
+// are compared identifier by identifier (numeric identifiers compare
+// numerically and always sort lower than alphanumeric ones); build
+// metadata is ignored entirely. It returns -1, 0, or 1.
+func (v Version) Cmp(o Version) int {
+	if c := cmpUint(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := cmpUint(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := cmpUint(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(v.Prerelease) == 0 && len(o.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(o.Prerelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(o.Prerelease); i++ {
+		if c := cmpIdentifier(v.Prerelease[i], o.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return cmpUint(uint64(len(v.Prerelease)), uint64(len(o.Prerelease)))
+}
+
+func cmpUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func cmpIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	aNumeric, bNumeric := aErr == nil, bErr == nil
+
+	switch {
+	case aNumeric && bNumeric:
+		return cmpUint(an, bn)
+	case aNumeric:
+		return -1
+	case bNumeric:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// Less reports whether a sorts before b.
+func Less(a, b Version) bool {
+	return a.Cmp(b) < 0
+}
@@ -0,0 +1,282 @@
+package semver
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    Version
+		wantErr bool
+	}{
+		{
+			name: "full version",
+			in:   "v1.2.3",
+			want: Version{Major: 1, Minor: 2, Patch: 3, raw: "v1.2.3"},
+		},
+		{
+			name: "no v prefix",
+			in:   "1.2.3",
+			want: Version{Major: 1, Minor: 2, Patch: 3, raw: "1.2.3"},
+		},
+		{
+			name: "missing patch and minor default to zero",
+			in:   "2",
+			want: Version{Major: 2, raw: "2"},
+		},
+		{
+			name: "prerelease and build metadata",
+			in:   "1.0.0-alpha.1+build.5",
+			want: Version{Major: 1, Prerelease: []string{"alpha", "1"}, Build: "build.5", raw: "1.0.0-alpha.1+build.5"},
+		},
+		{name: "empty prerelease", in: "1.0.0-", wantErr: true},
+		{name: "empty build", in: "1.0.0+", wantErr: true},
+		{name: "empty prerelease identifier", in: "1.0.0-alpha..1", wantErr: true},
+		{name: "too many components", in: "1.2.3.4", wantErr: true},
+		{name: "non-numeric component", in: "1.x.0", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Major != tt.want.Major || got.Minor != tt.want.Minor || got.Patch != tt.want.Patch {
+				t.Fatalf("Parse(%q) = %+v, want major/minor/patch %d/%d/%d", tt.in, got, tt.want.Major, tt.want.Minor, tt.want.Patch)
+			}
+			if len(got.Prerelease) != len(tt.want.Prerelease) {
+				t.Fatalf("Parse(%q).Prerelease = %v, want %v", tt.in, got.Prerelease, tt.want.Prerelease)
+			}
+			for i := range got.Prerelease {
+				if got.Prerelease[i] != tt.want.Prerelease[i] {
+					t.Fatalf("Parse(%q).Prerelease = %v, want %v", tt.in, got.Prerelease, tt.want.Prerelease)
+				}
+			}
+			if got.Build != tt.want.Build {
+				t.Fatalf("Parse(%q).Build = %q, want %q", tt.in, got.Build, tt.want.Build)
+			}
+			if got.String() != tt.in {
+				t.Fatalf("Parse(%q).String() = %q, want %q", tt.in, got.String(), tt.in)
+			}
+		})
+	}
+}
+
+func TestCore(t *testing.T) {
+	v, err := Parse("v1.2.3-rc.1+build")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := v.Core(); got != "1.2.3" {
+		t.Fatalf("Core() = %q, want %q", got, "1.2.3")
+	}
+}
+
+func TestCmpOrdering(t *testing.T) {
+	// The canonical precedence example from semver.org, lowest to highest.
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+
+	versions := make([]Version, len(ordered))
+	for i, s := range ordered {
+		v, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) failed: %v", s, err)
+		}
+		versions[i] = v
+	}
+
+	for i := 0; i < len(versions); i++ {
+		for j := 0; j < len(versions); j++ {
+			got := versions[i].Cmp(versions[j])
+			want := cmpUint(uint64(i), uint64(j))
+			if got != want {
+				t.Errorf("Cmp(%q, %q) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+}
+
+func TestCmpBuildMetadataIgnored(t *testing.T) {
+	a, _ := Parse("1.2.3+build.1")
+	b, _ := Parse("1.2.3+build.2")
+	if c := a.Cmp(b); c != 0 {
+		t.Fatalf("Cmp with differing build metadata = %d, want 0", c)
+	}
+}
+
+func TestLess(t *testing.T) {
+	a, _ := Parse("1.2.3")
+	b, _ := Parse("1.2.4")
+	if !Less(a, b) {
+		t.Fatalf("Less(1.2.3, 1.2.4) = false, want true")
+	}
+	if Less(b, a) {
+		t.Fatalf("Less(1.2.4, 1.2.3) = true, want false")
+	}
+}
+
+func TestParseSelectorWildcard(t *testing.T) {
+	sel, err := ParseSelector("*")
+	if err != nil {
+		t.Fatalf("ParseSelector(*) failed: %v", err)
+	}
+	for _, s := range []string{"0.0.1", "1.2.3", "9.9.9-alpha"} {
+		v, _ := Parse(s)
+		if !sel.Matches(v) {
+			t.Errorf("wildcard selector should match %q", s)
+		}
+	}
+}
+
+func TestParseSelectorXRange(t *testing.T) {
+	tests := []struct {
+		expr    string
+		match   []string
+		nomatch []string
+	}{
+		{expr: "1.x", match: []string{"1.0.0", "1.9.9"}, nomatch: []string{"0.9.9", "2.0.0"}},
+		{expr: "1.2.x", match: []string{"1.2.0", "1.2.9"}, nomatch: []string{"1.1.9", "1.3.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			sel, err := ParseSelector(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) failed: %v", tt.expr, err)
+			}
+			for _, s := range tt.match {
+				v, _ := Parse(s)
+				if !sel.Matches(v) {
+					t.Errorf("%q should match %q", tt.expr, s)
+				}
+			}
+			for _, s := range tt.nomatch {
+				v, _ := Parse(s)
+				if sel.Matches(v) {
+					t.Errorf("%q should not match %q", tt.expr, s)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSelectorTilde(t *testing.T) {
+	sel, err := ParseSelector("~1.2.3")
+	if err != nil {
+		t.Fatalf("ParseSelector failed: %v", err)
+	}
+	match := []string{"1.2.3", "1.2.9"}
+	nomatch := []string{"1.2.2", "1.3.0"}
+	for _, s := range match {
+		v, _ := Parse(s)
+		if !sel.Matches(v) {
+			t.Errorf("~1.2.3 should match %q", s)
+		}
+	}
+	for _, s := range nomatch {
+		v, _ := Parse(s)
+		if sel.Matches(v) {
+			t.Errorf("~1.2.3 should not match %q", s)
+		}
+	}
+}
+
+func TestParseSelectorTildeMajorOnly(t *testing.T) {
+	// "~1" (no minor specified) means >=1.0.0 <2.0.0, same as "^1".
+	sel, err := ParseSelector("~1")
+	if err != nil {
+		t.Fatalf("ParseSelector failed: %v", err)
+	}
+	match := []string{"1.0.0", "1.9.9"}
+	nomatch := []string{"0.9.9", "2.0.0"}
+	for _, s := range match {
+		v, _ := Parse(s)
+		if !sel.Matches(v) {
+			t.Errorf("~1 should match %q", s)
+		}
+	}
+	for _, s := range nomatch {
+		v, _ := Parse(s)
+		if sel.Matches(v) {
+			t.Errorf("~1 should not match %q", s)
+		}
+	}
+}
+
+func TestParseSelectorCaret(t *testing.T) {
+	tests := []struct {
+		expr    string
+		match   []string
+		nomatch []string
+	}{
+		// Major > 0: compatible within the same major version.
+		{expr: "^1.2.3", match: []string{"1.2.3", "1.9.9"}, nomatch: []string{"1.2.2", "2.0.0"}},
+		// Major == 0, minor > 0: only patch-level changes are compatible.
+		{expr: "^0.2.3", match: []string{"0.2.3", "0.2.9"}, nomatch: []string{"0.2.2", "0.3.0"}},
+		// Major == 0, minor == 0: only an exact patch match is compatible.
+		{expr: "^0.0.3", match: []string{"0.0.3"}, nomatch: []string{"0.0.2", "0.0.4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			sel, err := ParseSelector(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) failed: %v", tt.expr, err)
+			}
+			for _, s := range tt.match {
+				v, _ := Parse(s)
+				if !sel.Matches(v) {
+					t.Errorf("%q should match %q", tt.expr, s)
+				}
+			}
+			for _, s := range tt.nomatch {
+				v, _ := Parse(s)
+				if sel.Matches(v) {
+					t.Errorf("%q should not match %q", tt.expr, s)
+				}
+			}
+		})
+	}
+}
+
+func TestParseSelectorComparatorList(t *testing.T) {
+	sel, err := ParseSelector(">=1.2.0 <2.0.0")
+	if err != nil {
+		t.Fatalf("ParseSelector failed: %v", err)
+	}
+	match := []string{"1.2.0", "1.9.9"}
+	nomatch := []string{"1.1.9", "2.0.0"}
+	for _, s := range match {
+		v, _ := Parse(s)
+		if !sel.Matches(v) {
+			t.Errorf(">=1.2.0 <2.0.0 should match %q", s)
+		}
+	}
+	for _, s := range nomatch {
+		v, _ := Parse(s)
+		if sel.Matches(v) {
+			t.Errorf(">=1.2.0 <2.0.0 should not match %q", s)
+		}
+	}
+}
+
+func TestParseSelectorInvalid(t *testing.T) {
+	for _, expr := range []string{"1.2.x.y", "~not-a-version", "^", ">=1.2.0 <bad"} {
+		if _, err := ParseSelector(expr); err == nil {
+			t.Errorf("ParseSelector(%q) should have failed", expr)
+		}
+	}
+}
@@ -1,9 +1,6 @@
 package main
 
 import (
-	"archive/tar"
-	"archive/zip"
-	"compress/gzip"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,14 +10,17 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/urfave/cli/v2"
+
+	"github.com/vkhobor/task-net/scripts/assemble/internal/semver"
+	"github.com/vkhobor/task-net/scripts/assemble/store"
+	"github.com/vkhobor/task-net/scripts/assemble/tools"
 )
 
-// TaskRelease represents a GitHub release
-type TaskRelease struct {
+// GithubRelease represents one entry from a GitHub releases API response.
+type GithubRelease struct {
 	TagName string `json:"tag_name"`
 }
 
@@ -40,14 +40,18 @@ type NuGetResource struct {
 	ID   string `json:"@id"`
 }
 
-func fetchTaskVersions() ([]string, error) {
-	resp, err := http.Get("https://api.github.com/repos/go-task/task/releases?per_page=100")
+// fetchGithubReleaseVersions lists release tags for a "owner/repo" GitHub
+// repository, stripping the leading "v" and filtering out nightly/preview
+// builds (and, unless includePrerelease is set, SemVer prereleases).
+func fetchGithubReleaseVersions(repo string, includePrerelease bool) ([]string, error) {
+	url := fmt.Sprintf("https://api.github.com/repos/%s/releases?per_page=100", repo)
+	resp, err := http.Get(url)
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
-	var releases []TaskRelease
+	var releases []GithubRelease
 	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
 		return nil, err
 	}
@@ -55,8 +59,9 @@ func fetchTaskVersions() ([]string, error) {
 	var versions []string
 	for _, release := range releases {
 		version := strings.TrimPrefix(release.TagName, "v")
-		// Only include normal releases (no nightly, preview, etc.)
-		if isNormalVersion(version) {
+		// Only include normal releases (no nightly, preview, etc.) unless
+		// the caller explicitly asked for prerelease builds too.
+		if isNormalVersion(version, includePrerelease) {
 			versions = append(versions, version)
 		}
 	}
@@ -64,34 +69,15 @@ func fetchTaskVersions() ([]string, error) {
 	return versions, nil
 }
 
-func fetchNuGetVersions(packageId string) ([]string, error) {
-	// Get service index
-	resp, err := http.Get("https://api.nuget.org/v3/index.json")
+func fetchNuGetVersions(packageId string, includePrerelease bool) ([]string, error) {
+	packageBaseAddress, err := nugetServiceResource("PackageBaseAddress/3.0.0")
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var serviceIndex NuGetServiceIndexResponse
-	if err := json.NewDecoder(resp.Body).Decode(&serviceIndex); err != nil {
-		return nil, err
-	}
-
-	var packageBaseAddress string
-	for _, resource := range serviceIndex.Resources {
-		if resource.Type == "PackageBaseAddress/3.0.0" {
-			packageBaseAddress = resource.ID
-			break
-		}
-	}
-
-	if packageBaseAddress == "" {
-		return nil, fmt.Errorf("package base address not found")
-	}
 
 	// Get package versions
 	versionsURL := fmt.Sprintf("%s%s/index.json", packageBaseAddress, strings.ToLower(packageId))
-	resp, err = http.Get(versionsURL)
+	resp, err := http.Get(versionsURL)
 	if err != nil {
 		return nil, err
 	}
@@ -102,10 +88,10 @@ func fetchNuGetVersions(packageId string) ([]string, error) {
 		return nil, err
 	}
 
-	// Filter to normal versions only (no prerelease)
+	// Filter to normal versions only (no prerelease), unless requested.
 	var normalVersions []string
 	for _, version := range versionsResponse.Versions {
-		if isNormalVersion(version) && !strings.Contains(version, "-") {
+		if isNormalVersion(version, includePrerelease) {
 			normalVersions = append(normalVersions, version)
 		}
 	}
@@ -113,53 +99,72 @@ func fetchNuGetVersions(packageId string) ([]string, error) {
 	return normalVersions, nil
 }
 
-func isNormalVersion(version string) bool {
-	if strings.Contains(version, "nightly") ||
-		strings.Contains(version, "preview") ||
-		strings.Contains(version, "alpha") ||
-		strings.Contains(version, "beta") ||
-		strings.Contains(version, "rc") {
+// nugetServiceResource looks up the @id of a resource of the given
+// @type in the NuGet v3 service index (https://api.nuget.org/v3/index.json).
+func nugetServiceResource(resourceType string) (string, error) {
+	resp, err := http.Get("https://api.nuget.org/v3/index.json")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var serviceIndex NuGetServiceIndexResponse
+	if err := json.NewDecoder(resp.Body).Decode(&serviceIndex); err != nil {
+		return "", err
+	}
+
+	for _, resource := range serviceIndex.Resources {
+		if resource.Type == resourceType {
+			return resource.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("nuget service index has no resource of type %s", resourceType)
+}
+
+// isNormalVersion reports whether version is a real, parseable release
+// tag rather than a nightly/preview build, and whether it should be kept
+// given includePrerelease. Unparseable tags (e.g. "nightly") are never
+// considered normal.
+func isNormalVersion(version string, includePrerelease bool) bool {
+	if strings.Contains(version, "nightly") || strings.Contains(version, "preview") {
 		return false
 	}
 
-	// Must start with a digit
-	if len(version) == 0 || version[0] < '0' || version[0] > '9' {
+	v, err := semver.Parse(version)
+	if err != nil {
+		return false
+	}
+
+	if v.IsPrerelease() && !includePrerelease {
 		return false
 	}
 
 	return true
 }
 
+// normalizeVersion reduces version to its "major.minor.patch" core,
+// dropping any "v" prefix, prerelease, and build metadata, so that
+// equivalent Task and NuGet versions compare equal.
 func normalizeVersion(version string) string {
-	// Remove v prefix
-	version = strings.TrimPrefix(version, "v")
-
-	// Split by dots
-	parts := strings.Split(version, ".")
-
-	// Ensure 3 parts
-	for len(parts) < 3 {
-		parts = append(parts, "0")
+	v, err := semver.Parse(version)
+	if err != nil {
+		// Fall back to the raw string so callers still get a stable,
+		// if imprecise, comparison key for unparseable input.
+		return strings.TrimPrefix(version, "v")
 	}
-
-	return strings.Join(parts[:3], ".")
+	return v.Core()
 }
 
+// compareVersions orders v1 and v2 by SemVer 2.0.0 precedence, returning
+// -1, 0, or 1. Unparseable input falls back to a lexical comparison.
 func compareVersions(v1, v2 string) int {
-	parts1 := strings.Split(normalizeVersion(v1), ".")
-	parts2 := strings.Split(normalizeVersion(v2), ".")
-
-	for i := 0; i < 3; i++ {
-		n1, _ := strconv.Atoi(parts1[i])
-		n2, _ := strconv.Atoi(parts2[i])
-
-		if n1 < n2 {
-			return -1
-		} else if n1 > n2 {
-			return 1
-		}
+	p1, err1 := semver.Parse(v1)
+	p2, err2 := semver.Parse(v2)
+	if err1 != nil || err2 != nil {
+		return strings.Compare(v1, v2)
 	}
-	return 0
+	return p1.Cmp(p2)
 }
 
 func sortVersions(versions []string) []string {
@@ -173,215 +178,196 @@ func sortVersions(versions []string) []string {
 	return sorted
 }
 
-func getTaskFileName(platform, arch string) string {
-	if platform == "windows" {
-		platform = "windows"
+// versionSelector builds a Selector from the --min-version/--max-version
+// flag values. Either bound may be empty, in which case it is omitted
+// from the resulting expression; an empty selector matches everything.
+func versionSelector(min, max string) (semver.Selector, error) {
+	var clauses []string
+	if min != "" {
+		clauses = append(clauses, ">="+min)
 	}
-
-	switch arch {
-	case "amd64":
-		arch = "amd64"
-	case "arm64":
-		arch = "arm64"
-	case "arm":
-		arch = "arm"
-	case "386":
-		arch = "386"
+	if max != "" {
+		clauses = append(clauses, "<="+max)
 	}
-
-	ext := "tar.gz"
-	if platform == "windows" {
-		ext = "zip"
+	if len(clauses) == 0 {
+		return semver.ParseSelector("*")
 	}
-
-	return fmt.Sprintf("task_%s_%s.%s", platform, arch, ext)
+	return semver.ParseSelector(strings.Join(clauses, " "))
 }
 
-func downloadFile(url, dest string) error {
-	resp, err := http.Get(url)
+// checkVersionConstraints validates a single requested version against
+// the --min-version/--max-version/--include-prerelease flags, returning
+// a descriptive error if it falls outside them.
+func checkVersionConstraints(version, min, max string, includePrerelease bool) error {
+	v, err := semver.Parse(version)
 	if err != nil {
-		return err
+		return fmt.Errorf("invalid version %q: %w", version, err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("bad status: %s", resp.Status)
+	if v.IsPrerelease() && !includePrerelease {
+		return fmt.Errorf("%s is a prerelease version; pass --include-prerelease to allow it", version)
 	}
 
-	out, err := os.Create(dest)
+	sel, err := versionSelector(min, max)
 	if err != nil {
 		return err
 	}
-	defer out.Close()
-
-	_, err = io.Copy(out, resp.Body)
-	return err
-}
-
-func extractZip(src, dest string) error {
-	r, err := zip.OpenReader(src)
-	if err != nil {
-		return err
+	if !sel.Matches(v) {
+		return fmt.Errorf("%s does not satisfy selector %q", version, sel)
 	}
-	defer r.Close()
 
-	os.MkdirAll(dest, 0755)
+	return nil
+}
 
-	for _, f := range r.File {
-		rc, err := f.Open()
+// filterVersions keeps only the versions matching sel, skipping any that
+// fail to parse as SemVer.
+func filterVersions(versions []string, sel semver.Selector) []string {
+	var out []string
+	for _, raw := range versions {
+		v, err := semver.Parse(raw)
 		if err != nil {
-			return err
+			continue
 		}
-
-		path := filepath.Join(dest, f.Name)
-
-		if f.FileInfo().IsDir() {
-			os.MkdirAll(path, f.FileInfo().Mode())
-		} else {
-			os.MkdirAll(filepath.Dir(path), 0755)
-			outFile, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, f.FileInfo().Mode())
-			if err != nil {
-				rc.Close()
-				return err
-			}
-
-			_, err = io.Copy(outFile, rc)
-			outFile.Close()
-			if err != nil {
-				rc.Close()
-				return err
-			}
+		if sel.Matches(v) {
+			out = append(out, raw)
 		}
-		rc.Close()
 	}
-
-	return nil
+	return out
 }
 
-func extractTarGz(src, dest string) error {
-	file, err := os.Open(src)
-	if err != nil {
-		return err
+// defaultMaxExtractBytes caps how many decompressed bytes installTool
+// will write per archive when the caller hasn't set --max-extract-bytes,
+// as a backstop against zip/gzip bombs.
+const defaultMaxExtractBytes = 1 << 30 // 1 GiB
+
+// installTool downloads (or reuses from cache) a release of tool and
+// installs it as customName in outputDir. Checksum verification runs for
+// every tool: --skip-checksum and the --checksum override both apply
+// regardless of tool, but falling back to a published checksums file only
+// works for "task", since that is the only registry entry whose checksum
+// file format is known.
+func installTool(tool tools.Tool, cacheStore *store.Store, version, customName, outputDir, platform, arch string, skipChecksum bool, checksumOverride string, maxExtractBytes int64) error {
+	// Ensure version has 'v' prefix
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
 	}
-	defer file.Close()
 
-	gzr, err := gzip.NewReader(file)
-	if err != nil {
-		return err
+	// Create output directory up front; both the cache-hit and
+	// cache-miss paths need it.
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
 	}
-	defer gzr.Close()
-
-	tr := tar.NewReader(gzr)
 
-	for {
-		header, err := tr.Next()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return err
-		}
-
-		target := filepath.Join(dest, header.Name)
-
-		switch header.Typeflag {
-		case tar.TypeDir:
-			if err := os.MkdirAll(target, 0755); err != nil {
-				return err
-			}
-		case tar.TypeReg:
-			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
-				return err
-			}
+	destPath := filepath.Join(outputDir, customName)
+	if platform == "windows" && !strings.HasSuffix(customName, ".exe") {
+		destPath += ".exe"
+	}
 
-			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
-			if err != nil {
-				return err
-			}
+	binaryFileName := tool.BinaryName(platform, arch)
 
-			if _, err := io.Copy(f, tr); err != nil {
-				f.Close()
-				return err
+	if cacheStore != nil && cacheStore.Has(tool.Name, version, platform, arch, binaryFileName) {
+		fmt.Printf("Using cached %s %s for %s/%s\n", tool.Name, version, platform, arch)
+		if err := linkOrCopy(cacheStore.Path(tool.Name, version, platform, arch, binaryFileName), destPath); err != nil {
+			return fmt.Errorf("failed to copy from cache: %w", err)
+		}
+		if platform != "windows" {
+			if err := os.Chmod(destPath, 0755); err != nil {
+				return fmt.Errorf("failed to make executable: %w", err)
 			}
-			f.Close()
 		}
+		fmt.Printf("Installed %s %s for %s/%s as %s\n", tool.Name, version, platform, arch, destPath)
+		return nil
 	}
 
-	return nil
-}
-
-func downloadTask(version, customName, outputDir, platform, arch string) error {
-	// Ensure version has 'v' prefix
-	if !strings.HasPrefix(version, "v") {
-		version = "v" + version
-	}
-
-	fileName := getTaskFileName(platform, arch)
-	downloadUrl := fmt.Sprintf("https://github.com/go-task/task/releases/download/%s/%s", version, fileName)
+	assetName := tool.AssetName(version, platform, arch)
+	downloadUrl := tool.AssetURL(version, platform, arch)
 
 	// Create temp directory
-	tempDir, err := os.MkdirTemp("", "task-download-")
+	tempDir, err := os.MkdirTemp("", "task-net-download-")
 	if err != nil {
 		return fmt.Errorf("failed to create temp directory: %w", err)
 	}
 	defer os.RemoveAll(tempDir)
 
-	downloadPath := filepath.Join(tempDir, fileName)
+	downloadPath := filepath.Join(tempDir, assetName)
 
 	// Download
-	fmt.Printf("Downloading Task %s for %s/%s...\n", version, platform, arch)
-	err = downloadFile(downloadUrl, downloadPath)
+	fmt.Printf("Downloading %s %s for %s/%s...\n", tool.Name, version, platform, arch)
+	digest, err := downloadFileHashed(downloadUrl, downloadPath)
 	if err != nil {
 		return fmt.Errorf("failed to download: %w", err)
 	}
 
-	// Extract
-	extractDir := filepath.Join(tempDir, "extracted")
-	if platform == "windows" {
-		err = extractZip(downloadPath, extractDir)
-	} else {
-		err = extractTarGz(downloadPath, extractDir)
-	}
-
-	if err != nil {
-		return fmt.Errorf("failed to extract: %w", err)
-	}
-
-	// Create output directory
-	err = os.MkdirAll(outputDir, 0755)
-	if err != nil {
-		return fmt.Errorf("failed to create output directory: %w", err)
+	if err := checkDownloadChecksum(tool.Name, downloadPath, assetName, digest, version, skipChecksum, checksumOverride); err != nil {
+		return err
 	}
 
-	// Move binary to final location
-	taskBinary := "task"
-	if platform == "windows" {
-		taskBinary = "task.exe"
+	var srcPath string
+	switch tool.ResolveArchiveType(version, platform, arch) {
+	case tools.ArchiveRaw:
+		srcPath = downloadPath
+	case tools.ArchiveZip:
+		extractDir := filepath.Join(tempDir, "extracted")
+		if err := extractZip(downloadPath, extractDir, maxExtractBytes); err != nil {
+			return fmt.Errorf("failed to extract: %w", err)
+		}
+		srcPath = filepath.Join(extractDir, tool.ArchivePath(version, platform, arch))
+	case tools.ArchiveTarGz:
+		extractDir := filepath.Join(tempDir, "extracted")
+		if err := extractTarGz(downloadPath, extractDir, maxExtractBytes); err != nil {
+			return fmt.Errorf("failed to extract: %w", err)
+		}
+		srcPath = filepath.Join(extractDir, tool.ArchivePath(version, platform, arch))
 	}
 
-	srcPath := filepath.Join(extractDir, taskBinary)
-	destPath := filepath.Join(outputDir, customName)
-	if platform == "windows" && !strings.HasSuffix(customName, ".exe") {
-		destPath += ".exe"
+	if cacheStore != nil {
+		if err := cacheStore.Add(tool.Name, version, platform, arch, srcPath); err != nil {
+			return fmt.Errorf("failed to populate cache: %w", err)
+		}
 	}
 
-	err = os.Rename(srcPath, destPath)
-	if err != nil {
+	if err := linkOrCopy(srcPath, destPath); err != nil {
 		return fmt.Errorf("failed to move binary: %w", err)
 	}
 
 	// Make executable on Unix
 	if platform != "windows" {
-		err = os.Chmod(destPath, 0755)
-		if err != nil {
+		if err := os.Chmod(destPath, 0755); err != nil {
 			return fmt.Errorf("failed to make executable: %w", err)
 		}
 	}
 
-	fmt.Printf("Downloaded Task %s for %s/%s as %s\n", version, platform, arch, destPath)
+	fmt.Printf("Installed %s %s for %s/%s as %s\n", tool.Name, version, platform, arch, destPath)
 	return nil
 }
 
+// linkOrCopy hard-links src to dest, falling back to a plain byte copy
+// when hard-linking isn't possible (e.g. across filesystems).
+func linkOrCopy(src, dest string) error {
+	_ = os.Remove(dest)
+	if err := os.Link(src, dest); err == nil {
+		return nil
+	}
+	return copyFile(src, dest)
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
 func setVersion(csprojPath, version string) error {
 	content, err := os.ReadFile(csprojPath)
 	if err != nil {
@@ -421,20 +407,51 @@ func main() {
 		Commands: []*cli.Command{
 			{
 				Name:  "compare",
-				Usage: "Compare Task versions with NuGet package versions",
+				Usage: "Compare a registered tool's GitHub releases with NuGet package versions",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:  "tool",
+						Usage: fmt.Sprintf("Tool to compare (one of: %s)", strings.Join(tools.Names(), ", ")),
+						Value: "task",
+					},
+					&cli.StringFlag{
+						Name:  "min-version",
+						Usage: "Only consider versions >= this version",
+					},
+					&cli.StringFlag{
+						Name:  "max-version",
+						Usage: "Only consider versions <= this version",
+					},
+					&cli.BoolFlag{
+						Name:  "include-prerelease",
+						Usage: "Also consider prerelease versions (e.g. 1.2.0-rc1)",
+					},
+				},
 				Action: func(c *cli.Context) error {
 					if c.NArg() != 1 {
 						return fmt.Errorf("usage: task-net compare <nuget-package-id>")
 					}
 
+					tool, ok := tools.Get(c.String("tool"))
+					if !ok {
+						return fmt.Errorf("unknown tool %q (known tools: %s)", c.String("tool"), strings.Join(tools.Names(), ", "))
+					}
+
 					packageId := c.Args().First()
+					includePrerelease := c.Bool("include-prerelease")
 
-					taskVersions, err := fetchTaskVersions()
+					sel, err := versionSelector(c.String("min-version"), c.String("max-version"))
 					if err != nil {
-						return fmt.Errorf("failed to fetch Task versions: %w", err)
+						return err
+					}
+
+					toolVersions, err := fetchGithubReleaseVersions(tool.Repo, includePrerelease)
+					if err != nil {
+						return fmt.Errorf("failed to fetch %s versions: %w", tool.Name, err)
 					}
+					toolVersions = filterVersions(toolVersions, sel)
 
-					nugetVersions, err := fetchNuGetVersions(packageId)
+					nugetVersions, err := fetchNuGetVersions(packageId, includePrerelease)
 					if err != nil {
 						return fmt.Errorf("failed to fetch NuGet versions: %w", err)
 					}
@@ -445,19 +462,19 @@ func main() {
 						nugetSet[normalizeVersion(version)] = true
 					}
 
-					// Find Task versions not in NuGet
-					var taskOnly []string
-					for _, version := range taskVersions {
+					// Find tool versions not in NuGet
+					var toolOnly []string
+					for _, version := range toolVersions {
 						normalized := normalizeVersion(version)
 						if !nugetSet[normalized] {
-							taskOnly = append(taskOnly, version)
+							toolOnly = append(toolOnly, version)
 						}
 					}
 
 					// Sort and print results
-					taskOnly = sortVersions(taskOnly)
+					toolOnly = sortVersions(toolOnly)
 
-					for _, version := range taskOnly {
+					for _, version := range toolOnly {
 						fmt.Println(version)
 					}
 
@@ -465,13 +482,14 @@ func main() {
 				},
 			},
 			{
-				Name:  "download",
-				Usage: "Download a specific Task release",
+				Name:      "download",
+				Usage:     "Download a specific release of a registered tool",
+				ArgsUsage: "<tool>",
 				Flags: []cli.Flag{
 					&cli.StringFlag{
 						Name:     "version",
 						Aliases:  []string{"v"},
-						Usage:    "Task version to download",
+						Usage:    "Tool version to download",
 						Required: true,
 					},
 					&cli.StringFlag{
@@ -498,15 +516,269 @@ func main() {
 						Usage:   "Target architecture (amd64, arm64, arm, 386)",
 						Value:   runtime.GOARCH,
 					},
+					&cli.StringFlag{
+						Name:  "min-version",
+						Usage: "Reject --version if it is lower than this version",
+					},
+					&cli.StringFlag{
+						Name:  "max-version",
+						Usage: "Reject --version if it is higher than this version",
+					},
+					&cli.BoolFlag{
+						Name:  "include-prerelease",
+						Usage: "Allow --version to be a prerelease version (e.g. 1.2.0-rc1)",
+					},
+					&cli.BoolFlag{
+						Name:  "skip-checksum",
+						Usage: "Skip SHA-256 verification against task_checksums.txt",
+					},
+					&cli.StringFlag{
+						Name:  "checksum",
+						Usage: "Expected SHA-256 digest of the archive, overriding task_checksums.txt",
+					},
+					&cli.Int64Flag{
+						Name:  "max-extract-bytes",
+						Usage: "Maximum total decompressed bytes to write when unpacking the archive",
+						Value: defaultMaxExtractBytes,
+					},
 				},
 				Action: func(c *cli.Context) error {
+					if c.NArg() != 1 {
+						return fmt.Errorf("usage: task-net download <tool> (known tools: %s)", strings.Join(tools.Names(), ", "))
+					}
+
+					tool, ok := tools.Get(c.Args().First())
+					if !ok {
+						return fmt.Errorf("unknown tool %q (known tools: %s)", c.Args().First(), strings.Join(tools.Names(), ", "))
+					}
+
 					version := c.String("version")
 					name := c.String("name")
 					output := c.String("output")
 					platform := c.String("platform")
 					arch := c.String("arch")
 
-					return downloadTask(version, name, output, platform, arch)
+					if err := checkVersionConstraints(version, c.String("min-version"), c.String("max-version"), c.Bool("include-prerelease")); err != nil {
+						return err
+					}
+
+					cacheStore, err := store.NewDefault()
+					if err != nil {
+						return err
+					}
+
+					return installTool(tool, cacheStore, version, name, output, platform, arch, c.Bool("skip-checksum"), c.String("checksum"), c.Int64("max-extract-bytes"))
+				},
+			},
+			{
+				Name:  "verify",
+				Usage: "Verify a local Task archive against its published checksum",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "archive",
+						Aliases:  []string{"f"},
+						Usage:    "Path to the downloaded archive",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "version",
+						Aliases:  []string{"v"},
+						Usage:    "Task version the archive belongs to",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:  "checksum",
+						Usage: "Expected SHA-256 digest, overriding task_checksums.txt",
+					},
+				},
+				Action: func(c *cli.Context) error {
+					archivePath := c.String("archive")
+					version := c.String("version")
+					if !strings.HasPrefix(version, "v") {
+						version = "v" + version
+					}
+
+					if override := c.String("checksum"); override != "" {
+						got, err := hashFile(archivePath)
+						if err != nil {
+							return fmt.Errorf("failed to hash %s: %w", archivePath, err)
+						}
+						if !strings.EqualFold(got, override) {
+							return fmt.Errorf("checksum mismatch for %s: want %s, got %s", archivePath, strings.ToLower(override), got)
+						}
+						fmt.Printf("%s: OK (matches --checksum)\n", archivePath)
+						return nil
+					}
+
+					checksums, err := fetchChecksums(version)
+					if err != nil {
+						return fmt.Errorf("failed to fetch checksums: %w", err)
+					}
+
+					if err := verifyArchive(archivePath, filepath.Base(archivePath), checksums); err != nil {
+						return err
+					}
+
+					fmt.Printf("%s: OK\n", archivePath)
+					return nil
+				},
+			},
+			{
+				Name:  "cache",
+				Usage: "Inspect and manage the local tool binary cache",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "path",
+						Usage: "Print the cache root directory",
+						Action: func(c *cli.Context) error {
+							cacheStore, err := store.NewDefault()
+							if err != nil {
+								return err
+							}
+							fmt.Println(cacheStore.Root())
+							return nil
+						},
+					},
+					{
+						Name:  "list",
+						Usage: "List cached tool binaries",
+						Action: func(c *cli.Context) error {
+							cacheStore, err := store.NewDefault()
+							if err != nil {
+								return err
+							}
+
+							entries, err := cacheStore.List()
+							if err != nil {
+								return err
+							}
+
+							if len(entries) == 0 {
+								fmt.Println("cache is empty")
+								return nil
+							}
+
+							fmt.Printf("%-16s %-10s %-8s %-12s %s\n", "TOOL", "PLATFORM", "ARCH", "VERSION", "PATH")
+							for _, e := range entries {
+								fmt.Printf("%-16s %-10s %-8s %-12s %s\n", e.Tool, e.Platform, e.Arch, e.Version, e.Path)
+							}
+							return nil
+						},
+					},
+					{
+						Name:  "use",
+						Usage: "Copy a cached tool binary into an output directory",
+						Flags: []cli.Flag{
+							&cli.StringFlag{
+								Name:  "tool",
+								Usage: fmt.Sprintf("Tool to use (one of: %s)", strings.Join(tools.Names(), ", ")),
+								Value: "task",
+							},
+							&cli.StringFlag{
+								Name:     "version",
+								Aliases:  []string{"v"},
+								Usage:    "Tool version to use",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "name",
+								Aliases:  []string{"n"},
+								Usage:    "Custom name for the binary",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:     "output",
+								Aliases:  []string{"o"},
+								Usage:    "Output directory",
+								Required: true,
+							},
+							&cli.StringFlag{
+								Name:    "platform",
+								Aliases: []string{"p"},
+								Usage:   "Target platform (linux, darwin, windows)",
+								Value:   runtime.GOOS,
+							},
+							&cli.StringFlag{
+								Name:    "arch",
+								Aliases: []string{"a"},
+								Usage:   "Target architecture (amd64, arm64, arm, 386)",
+								Value:   runtime.GOARCH,
+							},
+						},
+						Action: func(c *cli.Context) error {
+							tool, ok := tools.Get(c.String("tool"))
+							if !ok {
+								return fmt.Errorf("unknown tool %q (known tools: %s)", c.String("tool"), strings.Join(tools.Names(), ", "))
+							}
+
+							version := c.String("version")
+							if !strings.HasPrefix(version, "v") {
+								version = "v" + version
+							}
+							platform := c.String("platform")
+							arch := c.String("arch")
+							binaryFileName := tool.BinaryName(platform, arch)
+
+							cacheStore, err := store.NewDefault()
+							if err != nil {
+								return err
+							}
+							if !cacheStore.Has(tool.Name, version, platform, arch, binaryFileName) {
+								return fmt.Errorf("%s %s for %s/%s is not cached; run download first", tool.Name, version, platform, arch)
+							}
+
+							output := c.String("output")
+							if err := os.MkdirAll(output, 0755); err != nil {
+								return fmt.Errorf("failed to create output directory: %w", err)
+							}
+
+							name := c.String("name")
+							destPath := filepath.Join(output, name)
+							if platform == "windows" && !strings.HasSuffix(name, ".exe") {
+								destPath += ".exe"
+							}
+
+							if err := linkOrCopy(cacheStore.Path(tool.Name, version, platform, arch, binaryFileName), destPath); err != nil {
+								return fmt.Errorf("failed to copy from cache: %w", err)
+							}
+							if platform != "windows" {
+								if err := os.Chmod(destPath, 0755); err != nil {
+									return fmt.Errorf("failed to make executable: %w", err)
+								}
+							}
+
+							fmt.Printf("Using cached %s %s for %s/%s as %s\n", tool.Name, version, platform, arch, destPath)
+							return nil
+						},
+					},
+					{
+						Name:  "cleanup",
+						Usage: "Remove all but the N most recent cached versions per tool/platform/arch",
+						Flags: []cli.Flag{
+							&cli.IntFlag{
+								Name:     "keep",
+								Usage:    "Number of most recent versions to retain per tool/platform/arch",
+								Required: true,
+							},
+						},
+						Action: func(c *cli.Context) error {
+							cacheStore, err := store.NewDefault()
+							if err != nil {
+								return err
+							}
+
+							removed, err := cacheStore.Cleanup(c.Int("keep"))
+							if err != nil {
+								return err
+							}
+
+							for _, e := range removed {
+								fmt.Printf("removed %s %s %s/%s\n", e.Tool, e.Version, e.Platform, e.Arch)
+							}
+							fmt.Printf("removed %d entr(ies)\n", len(removed))
+							return nil
+						},
+					},
 				},
 			},
 			{
@@ -532,6 +804,145 @@ func main() {
 					return setVersion(file, version)
 				},
 			},
+			{
+				Name:  "publish",
+				Usage: "Pack a csproj and push the resulting package to NuGet",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Aliases:  []string{"f"},
+						Usage:    "Path to csproj file",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "version",
+						Aliases:  []string{"v"},
+						Usage:    "Version to publish",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:    "api-key",
+						Usage:   "NuGet API key (defaults to $NUGET_API_KEY)",
+						EnvVars: []string{"NUGET_API_KEY"},
+					},
+				},
+				Action: func(c *cli.Context) error {
+					apiKey := c.String("api-key")
+					if apiKey == "" {
+						return fmt.Errorf("a NuGet API key is required via --api-key or $NUGET_API_KEY")
+					}
+					return publish(c.String("file"), c.String("version"), apiKey)
+				},
+			},
+			{
+				Name:  "sync",
+				Usage: "Compare, set-version, download and publish every Task version missing from NuGet",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Aliases:  []string{"f"},
+						Usage:    "Path to csproj file",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:     "output",
+						Aliases:  []string{"o"},
+						Usage:    "Directory to download each Task binary into",
+						Required: true,
+					},
+					&cli.StringFlag{
+						Name:    "name",
+						Aliases: []string{"n"},
+						Usage:   "Custom name for the downloaded binary",
+						Value:   "task",
+					},
+					&cli.StringFlag{
+						Name:    "platform",
+						Aliases: []string{"p"},
+						Usage:   "Target platform (linux, darwin, windows)",
+						Value:   runtime.GOOS,
+					},
+					&cli.StringFlag{
+						Name:    "arch",
+						Aliases: []string{"a"},
+						Usage:   "Target architecture (amd64, arm64, arm, 386)",
+						Value:   runtime.GOARCH,
+					},
+					&cli.StringFlag{
+						Name:    "api-key",
+						Usage:   "NuGet API key (defaults to $NUGET_API_KEY)",
+						EnvVars: []string{"NUGET_API_KEY"},
+					},
+				},
+				Action: func(c *cli.Context) error {
+					if c.NArg() != 1 {
+						return fmt.Errorf("usage: task-net sync <nuget-package-id>")
+					}
+
+					apiKey := c.String("api-key")
+					if apiKey == "" {
+						return fmt.Errorf("a NuGet API key is required via --api-key or $NUGET_API_KEY")
+					}
+
+					packageId := c.Args().First()
+
+					taskTool, ok := tools.Get("task")
+					if !ok {
+						return fmt.Errorf("task tool is not registered")
+					}
+
+					taskVersions, err := fetchGithubReleaseVersions(taskTool.Repo, false)
+					if err != nil {
+						return fmt.Errorf("failed to fetch Task versions: %w", err)
+					}
+
+					nugetVersions, err := fetchNuGetVersions(packageId, false)
+					if err != nil {
+						return fmt.Errorf("failed to fetch NuGet versions: %w", err)
+					}
+
+					nugetSet := make(map[string]bool)
+					for _, version := range nugetVersions {
+						nugetSet[normalizeVersion(version)] = true
+					}
+
+					var missing []string
+					for _, version := range taskVersions {
+						if !nugetSet[normalizeVersion(version)] {
+							missing = append(missing, version)
+						}
+					}
+					missing = sortVersions(missing)
+
+					if len(missing) == 0 {
+						fmt.Println("nothing to sync, NuGet is already up to date")
+						return nil
+					}
+
+					cacheStore, err := store.NewDefault()
+					if err != nil {
+						return err
+					}
+
+					for _, version := range missing {
+						fmt.Printf("=== syncing %s ===\n", version)
+
+						if err := setVersion(c.String("file"), version); err != nil {
+							return fmt.Errorf("sync %s: %w", version, err)
+						}
+
+						if err := installTool(taskTool, cacheStore, version, c.String("name"), c.String("output"), c.String("platform"), c.String("arch"), false, "", defaultMaxExtractBytes); err != nil {
+							return fmt.Errorf("sync %s: %w", version, err)
+						}
+
+						if err := publish(c.String("file"), version, apiKey); err != nil {
+							return fmt.Errorf("sync %s: %w", version, err)
+						}
+					}
+
+					return nil
+				},
+			},
 		},
 	}
 
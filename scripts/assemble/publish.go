@@ -0,0 +1,185 @@
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// dotnetPack shells out to `dotnet pack` to produce a .nupkg for csprojPath
+// at the given version, writing it into outDir and returning its path.
+func dotnetPack(csprojPath, version, outDir string) (string, error) {
+	cmd := exec.Command("dotnet", "pack", csprojPath,
+		"-c", "Release",
+		"-o", outDir,
+		"/p:Version="+version,
+	)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("dotnet pack failed: %w", err)
+	}
+
+	matches, err := filepath.Glob(filepath.Join(outDir, "*.nupkg"))
+	if err != nil {
+		return "", err
+	}
+	if len(matches) != 1 {
+		return "", fmt.Errorf("expected exactly one .nupkg in %s, found %d", outDir, len(matches))
+	}
+
+	return matches[0], nil
+}
+
+var nuspecIDRegexp = regexp.MustCompile(`<id>(.*?)</id>`)
+
+// nupkgPackageID opens nupkgPath and reads the package id out of its
+// embedded .nuspec manifest.
+func nupkgPackageID(nupkgPath string) (string, error) {
+	r, err := zip.OpenReader(nupkgPath)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	for _, f := range r.File {
+		if filepath.Ext(f.Name) != ".nuspec" {
+			continue
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return "", err
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return "", err
+		}
+
+		m := nuspecIDRegexp.FindSubmatch(data)
+		if m == nil {
+			return "", fmt.Errorf("no <id> found in %s", f.Name)
+		}
+		return string(m[1]), nil
+	}
+
+	return "", fmt.Errorf("%s contains no .nuspec manifest", nupkgPath)
+}
+
+// publishToNuGet pushes the .nupkg at nupkgPath to NuGet.org using the v3
+// PackagePublish/2.0.0 resource, authenticating with apiKey.
+func publishToNuGet(nupkgPath, apiKey string) error {
+	publishURL, err := nugetServiceResource("PackagePublish/2.0.0")
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(nupkgPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("package", filepath.Base(nupkgPath))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, f); err != nil {
+		return err
+	}
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPut, publishURL, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	req.Header.Set("X-NuGet-ApiKey", apiKey)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("nuget push failed: %s: %s", resp.Status, string(respBody))
+	}
+
+	return nil
+}
+
+// waitForNuGetVersion polls the PackageBaseAddress/3.0.0 flat container
+// index for packageID until version appears or timeout elapses.
+func waitForNuGetVersion(packageID, version string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	normalized := normalizeVersion(version)
+
+	for {
+		versions, err := fetchNuGetVersions(packageID, true)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range versions {
+			if normalizeVersion(v) == normalized {
+				return nil
+			}
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for %s %s to appear on NuGet", packageID, version)
+		}
+
+		time.Sleep(10 * time.Second)
+	}
+}
+
+// publish packs csprojPath at version and pushes it to NuGet, waiting for
+// the new version to become visible before returning.
+func publish(csprojPath, version, apiKey string) error {
+	tempDir, err := os.MkdirTemp("", "task-net-pack-")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	fmt.Printf("Packing %s at version %s...\n", csprojPath, version)
+	nupkgPath, err := dotnetPack(csprojPath, version, tempDir)
+	if err != nil {
+		return err
+	}
+
+	packageID, err := nupkgPackageID(nupkgPath)
+	if err != nil {
+		return fmt.Errorf("failed to read package id: %w", err)
+	}
+
+	fmt.Printf("Publishing %s %s to NuGet...\n", packageID, version)
+	if err := publishToNuGet(nupkgPath, apiKey); err != nil {
+		return fmt.Errorf("failed to publish: %w", err)
+	}
+
+	fmt.Printf("Waiting for %s %s to appear on NuGet...\n", packageID, version)
+	if err := waitForNuGetVersion(packageID, version, 5*time.Minute); err != nil {
+		return err
+	}
+
+	fmt.Printf("Published %s %s\n", packageID, version)
+	return nil
+}
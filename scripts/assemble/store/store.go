@@ -0,0 +1,257 @@
+// Package store implements a local on-disk cache of downloaded tool
+// binaries, keyed by tool, platform, architecture, and version, modeled
+// on the store layout used by controller-runtime's setup-envtest.
+package store
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/vkhobor/task-net/scripts/assemble/internal/semver"
+)
+
+// Entry describes one cached binary.
+type Entry struct {
+	Tool     string
+	Version  string
+	Platform string
+	Arch     string
+	Path     string
+}
+
+// Store is a local cache of tool binaries rooted at a directory laid out
+// as "<root>/<tool>/<platform>-<arch>/<version>/<binary>".
+type Store struct {
+	root string
+}
+
+// New returns a Store rooted at root.
+func New(root string) *Store {
+	return &Store{root: root}
+}
+
+// NewDefault returns a Store rooted at "$XDG_CACHE_HOME/task-net", falling
+// back to the OS default user cache directory when XDG_CACHE_HOME is
+// unset.
+func NewDefault() (*Store, error) {
+	base := os.Getenv("XDG_CACHE_HOME")
+	if base == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine cache directory: %w", err)
+		}
+		base = dir
+	}
+	return New(filepath.Join(base, "task-net")), nil
+}
+
+// Root returns the store's root directory.
+func (s *Store) Root() string {
+	return s.root
+}
+
+func platformArchDir(platform, arch string) string {
+	return fmt.Sprintf("%s-%s", platform, arch)
+}
+
+// Path returns the path at which binaryFileName for the given tool,
+// version, platform and arch would be stored, whether or not it
+// currently exists.
+func (s *Store) Path(tool, version, platform, arch, binaryFileName string) string {
+	return filepath.Join(s.root, tool, platformArchDir(platform, arch), version, binaryFileName)
+}
+
+// Has reports whether binaryFileName for the given tool, version,
+// platform and arch is already present in the store.
+func (s *Store) Has(tool, version, platform, arch, binaryFileName string) bool {
+	info, err := os.Stat(s.Path(tool, version, platform, arch, binaryFileName))
+	return err == nil && !info.IsDir()
+}
+
+// Add copies binaryPath into the store under the given tool, version,
+// platform and arch, creating any necessary directories. The cached file
+// keeps binaryPath's base name.
+func (s *Store) Add(tool, version, platform, arch, binaryPath string) error {
+	dest := s.Path(tool, version, platform, arch, filepath.Base(binaryPath))
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+
+	if err := copyFile(binaryPath, dest); err != nil {
+		return fmt.Errorf("failed to add %s to cache: %w", binaryPath, err)
+	}
+
+	if platform != "windows" {
+		if err := os.Chmod(dest, 0755); err != nil {
+			return fmt.Errorf("failed to make cached binary executable: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Remove deletes the cached binary for the given tool, version, platform
+// and arch, if present.
+func (s *Store) Remove(tool, version, platform, arch string) error {
+	dir := filepath.Join(s.root, tool, platformArchDir(platform, arch), version)
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to remove %s %s from cache: %w", tool, version, err)
+	}
+	return nil
+}
+
+// List returns every entry currently in the store, across all tools.
+func (s *Store) List() ([]Entry, error) {
+	var entries []Entry
+
+	toolDirs, err := os.ReadDir(s.root)
+	if os.IsNotExist(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cache root: %w", err)
+	}
+
+	for _, td := range toolDirs {
+		if !td.IsDir() {
+			continue
+		}
+
+		toolEntries, err := s.listTool(td.Name())
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, toolEntries...)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Tool != entries[j].Tool {
+			return entries[i].Tool < entries[j].Tool
+		}
+		if entries[i].Platform != entries[j].Platform {
+			return entries[i].Platform < entries[j].Platform
+		}
+		if entries[i].Arch != entries[j].Arch {
+			return entries[i].Arch < entries[j].Arch
+		}
+		vi, errI := semver.Parse(entries[i].Version)
+		vj, errJ := semver.Parse(entries[j].Version)
+		if errI != nil || errJ != nil {
+			return entries[i].Version < entries[j].Version
+		}
+		return vi.Cmp(vj) > 0
+	})
+
+	return entries, nil
+}
+
+func (s *Store) listTool(tool string) ([]Entry, error) {
+	var entries []Entry
+
+	toolDir := filepath.Join(s.root, tool)
+	platformDirs, err := os.ReadDir(toolDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", toolDir, err)
+	}
+
+	for _, pd := range platformDirs {
+		if !pd.IsDir() {
+			continue
+		}
+		platform, arch, ok := splitPlatformArchDir(pd.Name())
+		if !ok {
+			continue
+		}
+
+		versionDir := filepath.Join(toolDir, pd.Name())
+		versionDirs, err := os.ReadDir(versionDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", versionDir, err)
+		}
+
+		for _, vd := range versionDirs {
+			if !vd.IsDir() {
+				continue
+			}
+
+			binaries, err := os.ReadDir(filepath.Join(versionDir, vd.Name()))
+			if err != nil {
+				continue
+			}
+			for _, b := range binaries {
+				if b.IsDir() {
+					continue
+				}
+				entries = append(entries, Entry{
+					Tool:     tool,
+					Version:  vd.Name(),
+					Platform: platform,
+					Arch:     arch,
+					Path:     filepath.Join(versionDir, vd.Name(), b.Name()),
+				})
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// Cleanup retains only the keep most recent versions per tool/platform/arch
+// group, removing the rest. It returns the entries that were removed.
+func (s *Store) Cleanup(keep int) ([]Entry, error) {
+	entries, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]Entry)
+	for _, e := range entries {
+		key := e.Tool + "/" + platformArchDir(e.Platform, e.Arch)
+		groups[key] = append(groups[key], e)
+	}
+
+	var removed []Entry
+	for _, group := range groups {
+		// List already sorts newest-first within a tool/platform/arch group.
+		if len(group) <= keep {
+			continue
+		}
+		for _, e := range group[keep:] {
+			if err := s.Remove(e.Tool, e.Version, e.Platform, e.Arch); err != nil {
+				return removed, err
+			}
+			removed = append(removed, e)
+		}
+	}
+
+	return removed, nil
+}
+
+func splitPlatformArchDir(name string) (platform, arch string, ok bool) {
+	for i := len(name) - 1; i >= 0; i-- {
+		if name[i] == '-' {
+			return name[:i], name[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+func copyFile(src, dest string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
@@ -0,0 +1,134 @@
+package store
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func newSourceBinary(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write source binary: %v", err)
+	}
+	return path
+}
+
+func TestStoreAddHasPath(t *testing.T) {
+	s := New(t.TempDir())
+	src := newSourceBinary(t, "task", "v1")
+
+	if s.Has("task", "v1.2.3", "linux", "amd64", "task") {
+		t.Fatalf("Has should be false before Add")
+	}
+
+	if err := s.Add("task", "v1.2.3", "linux", "amd64", src); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+
+	if !s.Has("task", "v1.2.3", "linux", "amd64", "task") {
+		t.Fatalf("Has should be true after Add")
+	}
+	if s.Has("task", "v1.2.4", "linux", "amd64", "task") {
+		t.Fatalf("Has should be false for a different version")
+	}
+
+	want := filepath.Join(s.Root(), "task", "linux-amd64", "v1.2.3", "task")
+	if got := s.Path("task", "v1.2.3", "linux", "amd64", "task"); got != want {
+		t.Fatalf("Path() = %q, want %q", got, want)
+	}
+}
+
+func TestStoreRemove(t *testing.T) {
+	s := New(t.TempDir())
+	src := newSourceBinary(t, "task", "v1")
+
+	if err := s.Add("task", "v1.0.0", "linux", "amd64", src); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := s.Remove("task", "v1.0.0", "linux", "amd64"); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	if s.Has("task", "v1.0.0", "linux", "amd64", "task") {
+		t.Fatalf("Has should be false after Remove")
+	}
+}
+
+func TestStoreListSortsByToolPlatformArchAndVersionDesc(t *testing.T) {
+	s := New(t.TempDir())
+	src := newSourceBinary(t, "bin", "x")
+
+	add := func(tool, version, platform, arch string) {
+		t.Helper()
+		if err := s.Add(tool, version, platform, arch, src); err != nil {
+			t.Fatalf("Add(%s %s %s/%s) failed: %v", tool, version, platform, arch, err)
+		}
+	}
+
+	add("task", "v1.0.0", "linux", "amd64")
+	add("task", "v1.2.0", "linux", "amd64")
+	add("task", "v1.1.0", "linux", "amd64")
+	add("gh", "v2.0.0", "linux", "amd64")
+
+	entries, err := s.List()
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("List returned %d entries, want 4", len(entries))
+	}
+
+	want := []struct {
+		tool, version string
+	}{
+		{"gh", "v2.0.0"},
+		{"task", "v1.2.0"},
+		{"task", "v1.1.0"},
+		{"task", "v1.0.0"},
+	}
+	for i, w := range want {
+		if entries[i].Tool != w.tool || entries[i].Version != w.version {
+			t.Fatalf("entries[%d] = %s %s, want %s %s", i, entries[i].Tool, entries[i].Version, w.tool, w.version)
+		}
+	}
+}
+
+func TestStoreCleanupKeepsNewestPerGroup(t *testing.T) {
+	s := New(t.TempDir())
+	src := newSourceBinary(t, "bin", "x")
+
+	for _, v := range []string{"v1.0.0", "v1.1.0", "v1.2.0"} {
+		if err := s.Add("task", v, "linux", "amd64", src); err != nil {
+			t.Fatalf("Add(%s) failed: %v", v, err)
+		}
+	}
+	// A different platform/arch group should be unaffected by cleaning up
+	// the linux-amd64 group down to 1.
+	if err := s.Add("task", "v1.0.0", "darwin", "arm64", src); err != nil {
+		t.Fatalf("Add darwin/arm64 failed: %v", err)
+	}
+
+	removed, err := s.Cleanup(1)
+	if err != nil {
+		t.Fatalf("Cleanup failed: %v", err)
+	}
+	if len(removed) != 2 {
+		t.Fatalf("Cleanup removed %d entries, want 2", len(removed))
+	}
+	for _, e := range removed {
+		if e.Version == "v1.2.0" {
+			t.Fatalf("Cleanup should not have removed the newest version, removed %v", removed)
+		}
+	}
+
+	if !s.Has("task", "v1.2.0", "linux", "amd64", "bin") {
+		t.Fatalf("newest linux/amd64 version should survive Cleanup")
+	}
+	if s.Has("task", "v1.0.0", "linux", "amd64", "bin") {
+		t.Fatalf("older linux/amd64 version should have been removed by Cleanup")
+	}
+	if !s.Has("task", "v1.0.0", "darwin", "arm64", "bin") {
+		t.Fatalf("darwin/arm64 group should be untouched by cleaning up linux/amd64")
+	}
+}
@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+const githubReleaseURLTemplate = "https://github.com/{repo}/releases/download/{version}/{asset}"
+
+func exeSuffix(platform string) string {
+	if platform == "windows" {
+		return ".exe"
+	}
+	return ""
+}
+
+func tarOrZip(platform string) string {
+	if platform == "windows" {
+		return "zip"
+	}
+	return "tar.gz"
+}
+
+func taskTool() Tool {
+	return Tool{
+		Name: "task",
+		Repo: "go-task/task",
+		BinaryName: func(platform, arch string) string {
+			return "task" + exeSuffix(platform)
+		},
+		AssetName: func(version, platform, arch string) string {
+			return fmt.Sprintf("task_%s_%s.%s", platform, arch, tarOrZip(platform))
+		},
+		URLTemplate:         githubReleaseURLTemplate,
+		BinaryInsideArchive: "{binary}",
+	}
+}
+
+// ghPlatformName maps Go's GOOS to the platform name cli/cli uses in its
+// release asset names, which differs from GOOS on macOS.
+func ghPlatformName(platform string) string {
+	if platform == "darwin" {
+		return "macOS"
+	}
+	return platform
+}
+
+// ghArchiveExt returns cli/cli's archive extension for platform: unlike
+// most of this registry's other tools, gh ships .zip on both macOS and
+// Windows, and .tar.gz only on Linux.
+func ghArchiveExt(platform string) string {
+	if platform == "linux" {
+		return "tar.gz"
+	}
+	return "zip"
+}
+
+func ghTool() Tool {
+	return Tool{
+		Name: "gh",
+		Repo: "cli/cli",
+		BinaryName: func(platform, arch string) string {
+			return "gh" + exeSuffix(platform)
+		},
+		AssetName: func(version, platform, arch string) string {
+			return fmt.Sprintf("gh_%s_%s_%s.%s", strings.TrimPrefix(version, "v"), ghPlatformName(platform), arch, ghArchiveExt(platform))
+		},
+		URLTemplate:         githubReleaseURLTemplate,
+		BinaryInsideArchive: "gh_{version_no_v}_{platform}_{arch}/bin/{binary}",
+		ArchivePlatform:     ghPlatformName,
+	}
+}
+
+func dotnetFormatTool() Tool {
+	// dotnet-format is normally installed as a dotnet global tool via
+	// NuGet; this entry assumes a standalone archive matching the other
+	// tools' layout, for projects that vendor it alongside Task.
+	return Tool{
+		Name: "dotnet-format",
+		Repo: "dotnet/format",
+		BinaryName: func(platform, arch string) string {
+			return "dotnet-format" + exeSuffix(platform)
+		},
+		AssetName: func(version, platform, arch string) string {
+			return fmt.Sprintf("dotnet-format-%s-%s-%s.%s", strings.TrimPrefix(version, "v"), platform, arch, tarOrZip(platform))
+		},
+		URLTemplate:         githubReleaseURLTemplate,
+		BinaryInsideArchive: "{binary}",
+	}
+}
+
+func kubectlTool() Tool {
+	// kubectl isn't published as a GitHub release asset; it's served
+	// from the dl.k8s.io release bucket as a bare binary.
+	return Tool{
+		Name: "kubectl",
+		Repo: "kubernetes/kubernetes",
+		BinaryName: func(platform, arch string) string {
+			return "kubectl" + exeSuffix(platform)
+		},
+		AssetName: func(version, platform, arch string) string {
+			return "kubectl" + exeSuffix(platform)
+		},
+		URLTemplate: "https://dl.k8s.io/release/{version}/bin/" + "{platform}/{arch}/{asset}",
+		ArchiveType: ArchiveRaw,
+	}
+}
+
+func helmTool() Tool {
+	return Tool{
+		Name: "helm",
+		Repo: "helm/helm",
+		BinaryName: func(platform, arch string) string {
+			return "helm" + exeSuffix(platform)
+		},
+		AssetName: func(version, platform, arch string) string {
+			return fmt.Sprintf("helm-%s-%s-%s.%s", version, platform, arch, tarOrZip(platform))
+		},
+		URLTemplate:         githubReleaseURLTemplate,
+		BinaryInsideArchive: "{platform}-{arch}/{binary}",
+	}
+}
+
+func golangciLintTool() Tool {
+	return Tool{
+		Name: "golangci-lint",
+		Repo: "golangci/golangci-lint",
+		BinaryName: func(platform, arch string) string {
+			return "golangci-lint" + exeSuffix(platform)
+		},
+		AssetName: func(version, platform, arch string) string {
+			ver := strings.TrimPrefix(version, "v")
+			return fmt.Sprintf("golangci-lint-%s-%s-%s.%s", ver, platform, arch, tarOrZip(platform))
+		},
+		URLTemplate:         githubReleaseURLTemplate,
+		BinaryInsideArchive: "golangci-lint-{version_no_v}-{platform}-{arch}/{binary}",
+	}
+}
@@ -0,0 +1,140 @@
+// Package tools holds a registry of GitHub-released CLI tools that
+// task-net knows how to download, keyed by name, so commands like
+// `download` and `compare` aren't hardcoded to go-task/task.
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ArchiveType identifies how a downloaded asset needs to be unpacked to
+// reach its binary.
+type ArchiveType string
+
+const (
+	ArchiveTarGz ArchiveType = "tar.gz"
+	ArchiveZip   ArchiveType = "zip"
+	// ArchiveRaw means the downloaded asset is the binary itself, with
+	// nothing to extract.
+	ArchiveRaw ArchiveType = "raw"
+)
+
+// Tool describes how to locate, name, and unpack the releases of one
+// GitHub-released CLI.
+type Tool struct {
+	// Name is the registry key, e.g. "task", "gh", "kubectl".
+	Name string
+	// Repo is the "owner/repo" the releases live under.
+	Repo string
+	// BinaryName returns the file name the installed binary should have
+	// on disk, including any platform-specific extension (e.g. ".exe").
+	BinaryName func(platform, arch string) string
+	// AssetName returns the file name of the release asset to download
+	// for the given version/platform/arch.
+	AssetName func(version, platform, arch string) string
+	// URLTemplate is the download URL with "{repo}", "{version}",
+	// "{asset}", "{platform}" and "{arch}" placeholders.
+	URLTemplate string
+	// ArchiveType is how to unpack the asset. Leave empty to infer it
+	// from AssetName's file extension at resolution time (needed for
+	// tools that ship .tar.gz on most platforms but .zip on Windows).
+	ArchiveType ArchiveType
+	// BinaryInsideArchive is the path to the binary within the unpacked
+	// archive, with "{version}", "{version_no_v}", "{platform}",
+	// "{arch}" and "{binary}" placeholders. Ignored when the resolved
+	// ArchiveType is ArchiveRaw.
+	BinaryInsideArchive string
+	// ArchivePlatform remaps the platform string used to resolve
+	// BinaryInsideArchive's "{platform}" placeholder, for tools whose
+	// release archives use a different platform name than Go's GOOS
+	// (e.g. gh's "macOS" vs. "darwin"). Leave nil to use platform as-is.
+	ArchivePlatform func(platform string) string
+}
+
+func placeholders(version, platform, arch, binary string) *strings.Replacer {
+	return strings.NewReplacer(
+		"{version}", version,
+		"{version_no_v}", strings.TrimPrefix(version, "v"),
+		"{platform}", platform,
+		"{arch}", arch,
+		"{binary}", binary,
+	)
+}
+
+// AssetURL returns the full download URL for the given version/platform/arch.
+func (t Tool) AssetURL(version, platform, arch string) string {
+	asset := t.AssetName(version, platform, arch)
+	r := strings.NewReplacer(
+		"{repo}", t.Repo,
+		"{version}", version,
+		"{asset}", asset,
+		"{platform}", platform,
+		"{arch}", arch,
+	)
+	return r.Replace(t.URLTemplate)
+}
+
+// ResolveArchiveType returns t.ArchiveType if set, otherwise infers it
+// from the asset's file extension for this version/platform/arch.
+func (t Tool) ResolveArchiveType(version, platform, arch string) ArchiveType {
+	if t.ArchiveType != "" {
+		return t.ArchiveType
+	}
+
+	asset := t.AssetName(version, platform, arch)
+	switch {
+	case strings.HasSuffix(asset, ".zip"):
+		return ArchiveZip
+	case strings.HasSuffix(asset, ".tar.gz"):
+		return ArchiveTarGz
+	default:
+		return ArchiveRaw
+	}
+}
+
+// ArchivePath resolves BinaryInsideArchive's placeholders for the given
+// version/platform/arch.
+func (t Tool) ArchivePath(version, platform, arch string) string {
+	binary := t.BinaryName(platform, arch)
+	archivePlatform := platform
+	if t.ArchivePlatform != nil {
+		archivePlatform = t.ArchivePlatform(platform)
+	}
+	return placeholders(version, archivePlatform, arch, binary).Replace(t.BinaryInsideArchive)
+}
+
+var registry = map[string]Tool{}
+
+func register(t Tool) {
+	if _, exists := registry[t.Name]; exists {
+		panic(fmt.Sprintf("tools: duplicate registration of %q", t.Name))
+	}
+	registry[t.Name] = t
+}
+
+// Get looks up a tool by name.
+func Get(name string) (Tool, bool) {
+	t, ok := registry[name]
+	return t, ok
+}
+
+// Names returns every registered tool name, sorted.
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	register(taskTool())
+	register(ghTool())
+	register(dotnetFormatTool())
+	register(kubectlTool())
+	register(helmTool())
+	register(golangciLintTool())
+}
@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRegistryHasAllBuiltins(t *testing.T) {
+	want := []string{"task", "gh", "dotnet-format", "kubectl", "helm", "golangci-lint"}
+	for _, name := range want {
+		if _, ok := Get(name); !ok {
+			t.Errorf("expected %q to be registered", name)
+		}
+	}
+}
+
+// TestAssetURLAndArchivePath resolves every registered tool's download URL,
+// archive type, and in-archive binary path across platforms, so a template
+// mistake like hardcoding one platform's archive extension (as helm's did)
+// is caught without manual inspection.
+func TestAssetURLAndArchivePath(t *testing.T) {
+	platforms := []struct{ platform, arch string }{
+		{"linux", "amd64"},
+		{"darwin", "arm64"},
+		{"windows", "amd64"},
+	}
+
+	for _, name := range Names() {
+		tool, _ := Get(name)
+		for _, p := range platforms {
+			t.Run(name+"/"+p.platform+"/"+p.arch, func(t *testing.T) {
+				version := "v1.2.3"
+
+				url := tool.AssetURL(version, p.platform, p.arch)
+				if url == "" {
+					t.Fatalf("AssetURL returned empty string")
+				}
+				if containsPlaceholder(url) {
+					t.Fatalf("AssetURL left a template placeholder unresolved: %q", url)
+				}
+
+				archiveType := tool.ResolveArchiveType(version, p.platform, p.arch)
+				asset := tool.AssetName(version, p.platform, p.arch)
+				switch {
+				case strings.HasSuffix(asset, ".zip"):
+					if archiveType != ArchiveZip {
+						t.Errorf("asset %q resolved to archive type %q, want zip", asset, archiveType)
+					}
+				case strings.HasSuffix(asset, ".tar.gz"):
+					if archiveType != ArchiveTarGz {
+						t.Errorf("asset %q resolved to archive type %q, want tar.gz", asset, archiveType)
+					}
+				default:
+					if archiveType != ArchiveRaw {
+						t.Errorf("asset %q resolved to archive type %q, want raw", asset, archiveType)
+					}
+				}
+
+				if archiveType == ArchiveRaw {
+					return
+				}
+
+				archivePath := tool.ArchivePath(version, p.platform, p.arch)
+				if archivePath == "" {
+					t.Fatalf("ArchivePath returned empty string")
+				}
+				if containsPlaceholder(archivePath) {
+					t.Fatalf("ArchivePath left a template placeholder unresolved: %q", archivePath)
+				}
+			})
+		}
+	}
+}
+
+func TestGhUsesMacOSAssetNameOnDarwin(t *testing.T) {
+	tool, ok := Get("gh")
+	if !ok {
+		t.Fatal("gh not registered")
+	}
+
+	url := tool.AssetURL("v2.40.1", "darwin", "amd64")
+	if !strings.Contains(url, "gh_2.40.1_macOS_amd64.zip") {
+		t.Errorf("AssetURL(darwin) = %q, want it to contain gh_2.40.1_macOS_amd64.zip", url)
+	}
+
+	archivePath := tool.ArchivePath("v2.40.1", "darwin", "amd64")
+	want := "gh_2.40.1_macOS_amd64/bin/gh"
+	if archivePath != want {
+		t.Errorf("ArchivePath(darwin) = %q, want %q", archivePath, want)
+	}
+
+	// Other platforms should be unaffected by the macOS remapping.
+	if url := tool.AssetURL("v2.40.1", "linux", "amd64"); !strings.Contains(url, "gh_2.40.1_linux_amd64.tar.gz") {
+		t.Errorf("AssetURL(linux) = %q, want it to contain gh_2.40.1_linux_amd64.tar.gz", url)
+	}
+}
+
+func containsPlaceholder(s string) bool {
+	for _, p := range []string{"{repo}", "{version}", "{version_no_v}", "{platform}", "{arch}", "{asset}", "{binary}"} {
+		if strings.Contains(s, p) {
+			return true
+		}
+	}
+	return false
+}